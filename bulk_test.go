@@ -0,0 +1,36 @@
+package set
+
+import "testing"
+
+func TestFromSliceToSlice(test *testing.T) {
+	s := FromSlice([]int{1, 2, 3, 2})
+	if s.Len() != 3 {
+		test.Fatalf("Len = %d, want 3", s.Len())
+	}
+
+	got := make(map[int]bool)
+	for _, e := range s.ToSlice() {
+		got[e] = true
+	}
+	if len(got) != 3 || !got[1] || !got[2] || !got[3] {
+		test.Fatalf("unexpected ToSlice result: %v", got)
+	}
+}
+
+func TestPutAllDeleteAllClear(test *testing.T) {
+	s := New[string]()
+	s.PutAll("a", "b", "c")
+	if s.Len() != 3 {
+		test.Fatalf("Len = %d, want 3", s.Len())
+	}
+
+	s.DeleteAll("a", "b")
+	if s.Len() != 1 || !s.Has("c") {
+		test.Fatalf("unexpected state after DeleteAll: %s", s)
+	}
+
+	s.Clear()
+	if s.Len() != 0 {
+		test.Fatalf("Len = %d after Clear, want 0", s.Len())
+	}
+}
@@ -0,0 +1,29 @@
+package set
+
+import "fmt"
+
+// Pair is an ordered pair of values, used as the member type of a
+// CartesianProduct.
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// String returns the string representation of p.
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// CartesianProduct returns the set of all pairs (x, y) with x in a and y in
+// b.
+func CartesianProduct[A, B comparable](a Set[A], b Set[B]) Set[Pair[A, B]] {
+	p := New[Pair[A, B]]()
+	a.Range(func(x A) bool {
+		b.Range(func(y B) bool {
+			p.Put(Pair[A, B]{First: x, Second: y})
+			return true
+		})
+		return true
+	})
+	return p
+}
@@ -0,0 +1,53 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// marshalJSON returns the JSON encoding of s, as a flat array of members,
+// sorted when T has a natural ordering. It backs MarshalJSON on both
+// safeSet and unsafeSet so the two concrete types share one implementation.
+func marshalJSON[T comparable](s Set[T]) ([]byte, error) {
+	xs := s.ToSlice()
+	sortIfOrdered(xs)
+	return json.Marshal(xs)
+}
+
+// unmarshalJSON decodes a flat JSON array of members into s, collapsing any
+// duplicates silently. It backs UnmarshalJSON on both safeSet and
+// unsafeSet.
+func unmarshalJSON[T comparable](s Set[T], data []byte) error {
+	var xs []T
+	if err := json.Unmarshal(data, &xs); err != nil {
+		return err
+	}
+	s.PutAll(xs...)
+	return nil
+}
+
+// gobEncode returns the gob encoding of s, as a flat slice of members,
+// sorted when T has a natural ordering. It backs GobEncode on both safeSet
+// and unsafeSet.
+func gobEncode[T comparable](s Set[T]) ([]byte, error) {
+	xs := s.ToSlice()
+	sortIfOrdered(xs)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(xs); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gobDecode decodes a gob-encoded slice of members into s, collapsing any
+// duplicates silently. It backs GobDecode on both safeSet and unsafeSet.
+func gobDecode[T comparable](s Set[T], data []byte) error {
+	var xs []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&xs); err != nil {
+		return err
+	}
+	s.PutAll(xs...)
+	return nil
+}
@@ -0,0 +1,27 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestGobRoundTrip(test *testing.T) {
+	s := NewSafe[int]()
+	s.Put(3)
+	s.Put(1)
+	s.Put(2)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s); err != nil {
+		test.Fatal(err)
+	}
+
+	got := NewSafe[int]()
+	if err := gob.NewDecoder(&buf).Decode(got); err != nil {
+		test.Fatal(err)
+	}
+	if !got.Equal(s) {
+		test.Fatalf("roundtrip mismatch: %s != %s", got, s)
+	}
+}
@@ -0,0 +1,62 @@
+package set
+
+import "sync"
+
+// Iterator provides pull-based iteration over a Set, as an alternative to
+// Range for callers that need to interleave set iteration with other
+// blocking operations rather than handing control to a callback.
+//
+// Iterator is the only iteration primitive this package exposes beyond
+// Range. An earlier revision also exposed a bare Iter() <-chan T, but a
+// plain receive-only channel gives an abandoning consumer no way to signal
+// "I'm done" — `for range s.Iter() { break }` left the feeder goroutine
+// parked forever on its send, holding a thread-safe set's read lock and
+// deadlocking every later writer. Iterator's Stop method closes that gap,
+// so Iter was removed rather than patched; this is a deliberate, permanent
+// scope cut, not an oversight.
+type Iterator[T any] struct {
+	C    <-chan T
+	stop chan struct{}
+	once sync.Once
+}
+
+// Next returns the next member of the set and true, or the zero value and
+// false once iteration is exhausted.
+func (it *Iterator[T]) Next() (T, bool) {
+	e, ok := <-it.C
+	return e, ok
+}
+
+// Stop releases the iterator's underlying goroutine and, for thread-safe
+// sets, its read lock. It is safe to call Stop more than once, and safe to
+// call it after iteration has already been drained.
+func (it *Iterator[T]) Stop() {
+	it.once.Do(func() { close(it.stop) })
+}
+
+// newIterator builds an Iterator that sends the values produced by next
+// over its channel, stopping early if Stop is called. unlock, if non-nil,
+// is invoked exactly once when the goroutine exits, whether by exhausting
+// next or by Stop.
+func newIterator[T any](next func(yield func(T) bool), unlock func()) *Iterator[T] {
+	c := make(chan T)
+	stop := make(chan struct{})
+	it := &Iterator[T]{C: c, stop: stop}
+
+	go func() {
+		if unlock != nil {
+			defer unlock()
+		}
+		defer close(c)
+
+		next(func(e T) bool {
+			select {
+			case c <- e:
+				return true
+			case <-stop:
+				return false
+			}
+		})
+	}()
+	return it
+}
@@ -0,0 +1,75 @@
+package set
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIteratorDrainsAllMembers(test *testing.T) {
+	s := NewSafe[string]()
+	s.Put("a")
+	s.Put("b")
+	s.Put("c")
+
+	got := make(map[string]bool)
+	it := s.Iterator()
+	for {
+		e, ok := it.Next()
+		if !ok {
+			break
+		}
+		got[e] = true
+	}
+	if len(got) != 3 || !got["a"] || !got["b"] || !got["c"] {
+		test.Fatalf("unexpected members from Iterator: %v", got)
+	}
+}
+
+func TestIteratorAbandonedEarlyReleasesLock(test *testing.T) {
+	s := NewSafe[int]()
+	for i := 0; i < 5; i++ {
+		s.Put(i)
+	}
+
+	it := s.Iterator()
+	if _, ok := it.Next(); !ok {
+		test.Fatal("expected at least one element before abandoning")
+	}
+	it.Stop() // caller abandons iteration without draining
+
+	done := make(chan struct{})
+	go func() {
+		s.Put(99)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		test.Fatal("Put deadlocked after abandoning Iterator early")
+	}
+}
+
+func TestIteratorStopReleasesLock(test *testing.T) {
+	s := NewSafe[int]()
+	for i := 0; i < 5; i++ {
+		s.Put(i)
+	}
+
+	it := s.Iterator()
+	if _, ok := it.Next(); !ok {
+		test.Fatal("expected at least one element before stopping")
+	}
+	it.Stop()
+	it.Stop() // must be safe to call twice
+
+	done := make(chan struct{})
+	go func() {
+		s.Put(99)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		test.Fatal("Put deadlocked after Iterator.Stop")
+	}
+}
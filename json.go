@@ -0,0 +1,57 @@
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// decodeOptions holds the options accepted by DecodeJSON.
+type decodeOptions struct {
+	strict bool
+}
+
+// DecodeOption configures the behavior of DecodeJSON.
+type DecodeOption func(*decodeOptions)
+
+// WithStrictDecode causes DecodeJSON to fail on the first duplicate member
+// it encounters, rather than collapsing duplicates silently as repeated Put
+// calls would.
+func WithStrictDecode() DecodeOption {
+	return func(o *decodeOptions) { o.strict = true }
+}
+
+// DecodeJSON decodes a flat JSON array of members into a new, mutex-guarded
+// Set. Go's generics cannot dispatch json.Unmarshal on T directly, so this
+// is provided as a free function rather than a method on the Set[T]
+// interface. By default duplicate members are collapsed silently; pass
+// WithStrictDecode to reject them instead.
+//
+// Set[T]'s own UnmarshalJSON (used when a Set is embedded in a struct
+// decoded via encoding/json) always collapses duplicates, since it has no
+// way to accept per-call options; use DecodeJSON directly when strict
+// duplicate checking is needed.
+func DecodeJSON[T comparable](data []byte, opts ...DecodeOption) (Set[T], error) {
+	var o decodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var xs []T
+	if err := json.Unmarshal(data, &xs); err != nil {
+		return nil, err
+	}
+
+	if o.strict {
+		seen := make(map[T]struct{}, len(xs))
+		for _, e := range xs {
+			if _, ok := seen[e]; ok {
+				return nil, fmt.Errorf("set: duplicate member %v", e)
+			}
+			seen[e] = struct{}{}
+		}
+	}
+
+	s := NewSafe[T]()
+	s.PutAll(xs...)
+	return s, nil
+}
@@ -0,0 +1,33 @@
+package set
+
+import "testing"
+
+func TestJSONRoundTrip(test *testing.T) {
+	s := NewSafe[int]()
+	s.Put(3)
+	s.Put(1)
+	s.Put(2)
+
+	data, err := s.EncodeJSON()
+	if err != nil {
+		test.Fatal(err)
+	}
+	if string(data) != "[1,2,3]" {
+		test.Fatalf("expected sorted JSON array, got %s", data)
+	}
+
+	got, err := DecodeJSON[int](data)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if !got.Equal(s) {
+		test.Fatalf("roundtrip mismatch: %s != %s", got, s)
+	}
+}
+
+func TestJSONStrictDecodeDuplicate(test *testing.T) {
+	_, err := DecodeJSON[string]([]byte(`["a","b","a"]`), WithStrictDecode())
+	if err == nil {
+		test.Fatal("expected error decoding duplicate member in strict mode")
+	}
+}
@@ -0,0 +1,44 @@
+package set
+
+import (
+	"reflect"
+	"sort"
+)
+
+// sortIfOrdered sorts xs in place when T's underlying kind has a natural
+// ordering (the signed/unsigned integer kinds, the float kinds, or string),
+// so that encoders can produce a deterministic member ordering. For any
+// other kind, xs is left in whatever order it was built in.
+func sortIfOrdered[T any](xs []T) {
+	if len(xs) < 2 {
+		return
+	}
+
+	switch reflect.TypeOf((*T)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+	default:
+		return
+	}
+
+	sort.Slice(xs, func(i, j int) bool {
+		return lessKind(reflect.ValueOf(xs[i]), reflect.ValueOf(xs[j]))
+	})
+}
+
+// lessKind compares two reflect.Values known to share one of the kinds
+// handled by sortIfOrdered.
+func lessKind(a, b reflect.Value) bool {
+	switch a.Kind() {
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	default:
+		return a.Int() < b.Int()
+	}
+}
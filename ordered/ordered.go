@@ -0,0 +1,229 @@
+// The ordered package provides a concurrency-safe set with deterministic,
+// sorted iteration, for the common case (APIs, diffs, tests) where the
+// randomized iteration order of the parent set package is unusable.
+package ordered
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Ordered constrains the types a Set may hold: anything with a natural
+// less-than ordering.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Set is a concurrency-safe set with stable, sorted iteration order. It is
+// backed by a map for O(1) membership tests alongside a sorted slice for
+// in-order iteration. Has is O(1); Put and Delete locate their member via
+// binary search in O(log n) but are themselves O(n) overall, since keeping
+// that slice sorted requires shifting it on every insert or removal. For
+// write-heavy workloads where this matters, keep inserting into a plain
+// map-backed Set and only build an ordered Set from it when sorted output is
+// actually needed.
+type Set[T Ordered] struct {
+	mu sync.RWMutex
+	m  map[T]struct{}
+	xs []T // kept sorted ascending; mirrors m
+}
+
+// New returns a new Set.
+func New[T Ordered]() *Set[T] {
+	return &Set[T]{m: make(map[T]struct{})}
+}
+
+// Put puts e into s. It is O(n) in the worst case: locating e is O(log n),
+// but keeping the sorted slice contiguous requires an O(n) shift.
+func (s *Set[T]) Put(e T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.m[e]; ok {
+		return
+	}
+	s.m[e] = struct{}{}
+
+	i := sort.Search(len(s.xs), func(i int) bool { return s.xs[i] >= e })
+	s.xs = append(s.xs, e)
+	copy(s.xs[i+1:], s.xs[i:])
+	s.xs[i] = e
+}
+
+// Delete deletes e from s. Like Put, it is O(n) in the worst case: locating
+// e is O(log n), but keeping the sorted slice contiguous requires an O(n)
+// shift.
+func (s *Set[T]) Delete(e T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.m[e]; !ok {
+		return
+	}
+	delete(s.m, e)
+
+	i := sort.Search(len(s.xs), func(i int) bool { return s.xs[i] >= e })
+	s.xs = append(s.xs[:i], s.xs[i+1:]...)
+}
+
+// Has returns true if s has e.
+func (s *Set[T]) Has(e T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.m[e]
+	return ok
+}
+
+// Len returns the number of members of s.
+func (s *Set[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.xs)
+}
+
+// Range calls f for each member of s in ascending order, so long as it
+// returns true.
+func (s *Set[T]) Range(f func(T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, e := range s.xs {
+		if !f(e) {
+			break
+		}
+	}
+}
+
+// RangeFrom calls f for each member e of s with lo <= e <= hi, in ascending
+// order, so long as it returns true.
+func (s *Set[T]) RangeFrom(lo, hi T, f func(T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	start := sort.Search(len(s.xs), func(i int) bool { return s.xs[i] >= lo })
+	for _, e := range s.xs[start:] {
+		if e > hi {
+			break
+		}
+		if !f(e) {
+			break
+		}
+	}
+}
+
+// SortedList returns the members of s as a slice in ascending order.
+func (s *Set[T]) SortedList() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	out := make([]T, len(s.xs))
+	copy(out, s.xs)
+	return out
+}
+
+// Min returns the smallest member of s, and false if s is empty.
+func (s *Set[T]) Min() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var zero T
+	if len(s.xs) == 0 {
+		return zero, false
+	}
+	return s.xs[0], true
+}
+
+// Max returns the largest member of s, and false if s is empty.
+func (s *Set[T]) Max() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var zero T
+	if len(s.xs) == 0 {
+		return zero, false
+	}
+	return s.xs[len(s.xs)-1], true
+}
+
+// Copy returns a copy of s.
+func (s *Set[T]) Copy() *Set[T] {
+	c := New[T]()
+	s.Range(func(e T) bool {
+		c.Put(e)
+		return true
+	})
+	return c
+}
+
+// String returns the string representation of s, with members in ascending
+// order.
+func (s *Set[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var sb strings.Builder
+	sb.WriteRune('{')
+	for i, e := range s.xs {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprint(&sb, e)
+	}
+	sb.WriteRune('}')
+	return sb.String()
+}
+
+// Union returns the union of sets, with members in ascending order.
+func Union[T Ordered](sets ...*Set[T]) *Set[T] {
+	u := New[T]()
+	for _, s := range sets {
+		s.Range(func(e T) bool {
+			u.Put(e)
+			return true
+		})
+	}
+	return u
+}
+
+// Intersection returns the intersection of sets, with members in ascending
+// order.
+func Intersection[T Ordered](sets ...*Set[T]) *Set[T] {
+	i := New[T]()
+	if len(sets) == 0 {
+		return i
+	}
+	smallest := sets[0]
+	for _, s := range sets {
+		if s.Len() < smallest.Len() {
+			smallest = s
+		}
+	}
+
+	smallest.Range(func(e T) bool {
+		for _, s := range sets {
+			if !s.Has(e) {
+				return true
+			}
+		}
+		i.Put(e)
+		return true
+	})
+	return i
+}
+
+// Difference returns the result of s-t, with members in ascending order.
+func Difference[T Ordered](s, t *Set[T]) *Set[T] {
+	d := New[T]()
+	s.Range(func(e T) bool {
+		if !t.Has(e) {
+			d.Put(e)
+		}
+		return true
+	})
+	return d
+}
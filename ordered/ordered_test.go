@@ -0,0 +1,70 @@
+package ordered
+
+import "testing"
+
+func TestOrderedSet(test *testing.T) {
+	s := New[int]()
+	s.Put(3)
+	s.Put(1)
+	s.Put(2)
+	s.Put(2) // duplicate
+
+	if got, want := s.SortedList(), []int{1, 2, 3}; !equalSlices(got, want) {
+		test.Fatalf("SortedList = %v, want %v", got, want)
+	}
+	if lo, ok := s.Min(); !ok || lo != 1 {
+		test.Fatalf("Min = %v, %v, want 1, true", lo, ok)
+	}
+	if hi, ok := s.Max(); !ok || hi != 3 {
+		test.Fatalf("Max = %v, %v, want 3, true", hi, ok)
+	}
+
+	s.Delete(2)
+	if s.Has(2) {
+		test.Fatal("expected 2 to be deleted")
+	}
+	if s.Len() != 2 {
+		test.Fatalf("Len = %d, want 2", s.Len())
+	}
+
+	var got []int
+	s.RangeFrom(1, 3, func(e int) bool {
+		got = append(got, e)
+		return true
+	})
+	if !equalSlices(got, []int{1, 3}) {
+		test.Fatalf("RangeFrom = %v, want [1 3]", got)
+	}
+}
+
+func TestOrderedSetAlgebra(test *testing.T) {
+	s := New[string]()
+	s.Put("a")
+	s.Put("b")
+
+	t := New[string]()
+	t.Put("b")
+	t.Put("c")
+
+	if got, want := Union(s, t).SortedList(), []string{"a", "b", "c"}; !equalSlices(got, want) {
+		test.Fatalf("Union = %v, want %v", got, want)
+	}
+	if got, want := Intersection(s, t).SortedList(), []string{"b"}; !equalSlices(got, want) {
+		test.Fatalf("Intersection = %v, want %v", got, want)
+	}
+	if got, want := Difference(s, t).SortedList(), []string{"a"}; !equalSlices(got, want) {
+		test.Fatalf("Difference = %v, want %v", got, want)
+	}
+}
+
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
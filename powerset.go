@@ -0,0 +1,47 @@
+package set
+
+import "fmt"
+
+// RangePowerSet calls f for each of the 2^n subsets of s, so long as f
+// returns true, without materializing all of them at once. It returns an
+// error if s has more than 63 members, since the subset count would
+// overflow a uint64 bitmask.
+func RangePowerSet[T comparable](s Set[T], f func(Set[T]) bool) error {
+	n := s.Len()
+	if n > 63 {
+		return fmt.Errorf("set: RangePowerSet: set has %d members, cannot exceed 63", n)
+	}
+
+	xs := make([]T, 0, n)
+	s.Range(func(e T) bool {
+		xs = append(xs, e)
+		return true
+	})
+
+	for mask := uint64(0); mask < uint64(1)<<uint(n); mask++ {
+		sub := New[T]()
+		for i, e := range xs {
+			if mask&(uint64(1)<<uint(i)) != 0 {
+				sub.Put(e)
+			}
+		}
+		if !f(sub) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PowerSet returns the set of all 2^n subsets of s. It returns an error if
+// s has more than 63 members; callers that only need to visit subsets
+// rather than hold them all in memory should use RangePowerSet instead.
+func PowerSet[T comparable](s Set[T]) (Set[Set[T]], error) {
+	p := New[Set[T]]()
+	if err := RangePowerSet(s, func(sub Set[T]) bool {
+		p.Put(sub)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
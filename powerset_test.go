@@ -0,0 +1,76 @@
+package set
+
+import "testing"
+
+func TestPowerSet(test *testing.T) {
+	s := New[int]()
+	s.Put(1)
+	s.Put(2)
+	s.Put(3)
+
+	p, err := PowerSet(s)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if p.Len() != 8 {
+		test.Fatalf("expected 8 subsets, got %d", p.Len())
+	}
+}
+
+func TestPowerSetOverflow(test *testing.T) {
+	s := New[int]()
+	for i := 0; i < 64; i++ {
+		s.Put(i)
+	}
+	if _, err := PowerSet(s); err == nil {
+		test.Fatal("expected overflow error for 64-member set")
+	}
+}
+
+func TestCartesianProduct(test *testing.T) {
+	a := New[int]()
+	a.Put(1)
+	a.Put(2)
+
+	b := New[string]()
+	b.Put("x")
+
+	p := CartesianProduct(a, b)
+	if p.Len() != 2 {
+		test.Fatalf("expected 2 pairs, got %d", p.Len())
+	}
+	if !p.Has(Pair[int, string]{First: 1, Second: "x"}) {
+		test.Fatal("expected pair (1, x) in product")
+	}
+}
+
+func TestSymmetricDifference(test *testing.T) {
+	s := New[int]()
+	s.Put(1)
+	s.Put(2)
+
+	t := New[int]()
+	t.Put(2)
+	t.Put(3)
+
+	sd := s.SymmetricDifference(t)
+	if sd.Len() != 2 || !sd.Has(1) || !sd.Has(3) {
+		test.Fatalf("unexpected symmetric difference: %s", sd)
+	}
+}
+
+func TestProperSubset(test *testing.T) {
+	s := New[int]()
+	s.Put(1)
+
+	t := New[int]()
+	t.Put(1)
+	t.Put(2)
+
+	if !s.ProperSubset(t) {
+		test.Fatal("expected s to be a proper subset of t")
+	}
+	if s.ProperSubset(s) {
+		test.Fatal("a set must not be a proper subset of itself")
+	}
+}
@@ -0,0 +1,241 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// safeSet is a Set implementation guarded by a sync.RWMutex, safe for
+// concurrent use by multiple goroutines.
+type safeSet[T comparable] struct {
+	m  map[T]struct{}
+	mu sync.RWMutex
+}
+
+// NewSafe returns a new Set whose methods may be called concurrently.
+func NewSafe[T comparable]() Set[T] {
+	return &safeSet[T]{m: make(map[T]struct{})}
+}
+
+// Put puts e into s.
+func (s *safeSet[T]) Put(e T) {
+	s.mu.Lock()
+	s.m[e] = struct{}{}
+	s.mu.Unlock()
+}
+
+// Delete deletes e from s.
+func (s *safeSet[T]) Delete(e T) {
+	s.mu.Lock()
+	delete(s.m, e)
+	s.mu.Unlock()
+}
+
+// Remove is an alias for Delete.
+func (s *safeSet[T]) Remove(e T) {
+	s.Delete(e)
+}
+
+// PutAll puts each of xs into s, taking the write lock once.
+func (s *safeSet[T]) PutAll(xs ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range xs {
+		s.m[e] = struct{}{}
+	}
+}
+
+// DeleteAll deletes each of xs from s, taking the write lock once.
+func (s *safeSet[T]) DeleteAll(xs ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range xs {
+		delete(s.m, e)
+	}
+}
+
+// Clear removes all members from s.
+func (s *safeSet[T]) Clear() {
+	s.mu.Lock()
+	s.m = make(map[T]struct{})
+	s.mu.Unlock()
+}
+
+// Has returns true if s has e.
+func (s *safeSet[T]) Has(e T) bool {
+	s.mu.RLock()
+	_, ok := s.m[e]
+	s.mu.RUnlock()
+	return ok
+}
+
+// Len returns the number of members of s.
+func (s *safeSet[T]) Len() int {
+	s.mu.RLock()
+	l := len(s.m)
+	s.mu.RUnlock()
+	return l
+}
+
+// Range calls f for each member of s, so long as it returns true.
+func (s *safeSet[T]) Range(f func(T) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for e := range s.m {
+		if !f(e) {
+			break
+		}
+	}
+}
+
+// Copy returns a copy of s.
+func (s *safeSet[T]) Copy() Set[T] {
+	c := NewSafe[T]()
+	s.Range(func(e T) bool {
+		c.Put(e)
+		return true
+	})
+	return c
+}
+
+// ToSlice returns the members of s as a slice, in no particular order.
+func (s *safeSet[T]) ToSlice() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	xs := make([]T, 0, len(s.m))
+	for e := range s.m {
+		xs = append(xs, e)
+	}
+	return xs
+}
+
+// Difference returns the result of s-t.
+func (s *safeSet[T]) Difference(t Set[T]) Set[T] {
+	d := NewSafe[T]()
+	s.Range(func(e T) bool {
+		if !t.Has(e) {
+			d.Put(e)
+		}
+		return true
+	})
+	return d
+}
+
+// Diff is a deprecated alias for Difference.
+//
+// Deprecated: use Difference instead.
+func (s *safeSet[T]) Diff(t Set[T]) Set[T] {
+	return s.Difference(t)
+}
+
+// SymmetricDifference returns the members in exactly one of s and t.
+func (s *safeSet[T]) SymmetricDifference(t Set[T]) Set[T] {
+	return Union[T](s.Difference(t), t.Difference(s))
+}
+
+// Subset returns true if s is a subset of t.
+func (s *safeSet[T]) Subset(t Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for e := range s.m {
+		if !t.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// ProperSubset returns true if s is a subset of t and the two are not equal.
+func (s *safeSet[T]) ProperSubset(t Set[T]) bool {
+	return s.Subset(t) && !s.Equal(t)
+}
+
+// ProperSuperset returns true if s is a superset of t and the two are not
+// equal.
+func (s *safeSet[T]) ProperSuperset(t Set[T]) bool {
+	return t.Subset(s) && !s.Equal(t)
+}
+
+// Equal returns true if s and t have the same members.
+func (s *safeSet[T]) Equal(t Set[T]) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.m) != t.Len() {
+		return false
+	}
+	for e := range s.m {
+		if !t.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of s.
+func (s *safeSet[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var sb strings.Builder
+	sb.WriteRune('{')
+
+	var i int
+	for e := range s.m {
+		i++
+		fmt.Fprint(&sb, e)
+		if i < len(s.m) {
+			sb.WriteString(", ")
+		}
+	}
+	sb.WriteRune('}')
+	return sb.String()
+}
+
+// Iterator returns a pull-based iterator over s's members. The returned
+// iterator holds s's read lock until it is either drained or stopped via
+// Iterator.Stop.
+func (s *safeSet[T]) Iterator() *Iterator[T] {
+	s.mu.RLock()
+	return newIterator[T](func(yield func(T) bool) {
+		for e := range s.m {
+			if !yield(e) {
+				return
+			}
+		}
+	}, s.mu.RUnlock)
+}
+
+// MarshalJSON returns the JSON encoding of s, as a flat array of members.
+func (s *safeSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// EncodeJSON returns the JSON encoding of s, as a flat array of members.
+func (s *safeSet[T]) EncodeJSON() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalJSON decodes a flat JSON array of members into s, collapsing any
+// duplicates silently. Use the package-level DecodeJSON with
+// WithStrictDecode for strict duplicate checking.
+func (s *safeSet[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[T](s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s *safeSet[T]) GobEncode() ([]byte, error) {
+	return gobEncode[T](s)
+}
+
+// GobDecode implements gob.GobDecoder, collapsing any duplicate members
+// silently.
+func (s *safeSet[T]) GobDecode(data []byte) error {
+	return gobDecode[T](s, data)
+}
@@ -1,180 +1,134 @@
-// The set package provides a concurrency-safe set implementation.
+// The set package provides a generic set implementation, in two variants: a
+// mutex-guarded implementation returned by NewSafe for concurrent use, and a
+// lock-free implementation returned by NewUnsafe for callers that already
+// serialize access externally and want to avoid paying for the redundant
+// locking.
 package set
 
-import (
-	"fmt"
-	"strings"
-	"sync"
-)
+// Set is the interface implemented by the set variants in this package: the
+// mutex-guarded implementation returned by NewSafe and the lock-free
+// implementation returned by NewUnsafe.
+type Set[T comparable] interface {
+	// Put puts e into the set.
+	Put(e T)
+	// Delete deletes e from the set.
+	Delete(e T)
+	// Remove is an alias for Delete.
+	Remove(e T)
+	// PutAll puts each of xs into the set, taking any internal lock once
+	// rather than once per element.
+	PutAll(xs ...T)
+	// DeleteAll deletes each of xs from the set, taking any internal lock
+	// once rather than once per element.
+	DeleteAll(xs ...T)
+	// Clear removes all members from the set.
+	Clear()
+	// Has returns true if the set has e.
+	Has(e T) bool
+	// Len returns the number of members of the set.
+	Len() int
+	// Range calls f for each member of the set, so long as it returns true.
+	Range(f func(T) bool)
+	// Copy returns a copy of the set, of the same concrete type as the receiver.
+	Copy() Set[T]
+	// ToSlice returns the members of the set as a slice, in no particular
+	// order.
+	ToSlice() []T
+	// Difference returns the result of s-t.
+	Difference(t Set[T]) Set[T]
+	// Diff is a deprecated alias for Difference.
+	//
+	// Deprecated: use Difference instead.
+	Diff(t Set[T]) Set[T]
+	// SymmetricDifference returns the members in exactly one of the set
+	// and t.
+	SymmetricDifference(t Set[T]) Set[T]
+	// Subset returns true if the set is a subset of t.
+	Subset(t Set[T]) bool
+	// ProperSubset returns true if the set is a subset of t and the two
+	// are not equal.
+	ProperSubset(t Set[T]) bool
+	// ProperSuperset returns true if the set is a superset of t and the
+	// two are not equal.
+	ProperSuperset(t Set[T]) bool
+	// Equal returns true if the set and t have the same members.
+	Equal(t Set[T]) bool
+	// String returns the string representation of the set.
+	String() string
+	// EncodeJSON returns the JSON encoding of the set, as a flat array of
+	// members.
+	EncodeJSON() ([]byte, error)
+	// Iterator returns a pull-based iterator over the set's members. The
+	// iterator's underlying lock, if any, is released once the iterator is
+	// either drained or stopped via Iterator.Stop; callers that may abandon
+	// iteration early must call Stop to avoid leaking it.
+	Iterator() *Iterator[T]
+}
+
+// New returns a new Set backed by the mutex-guarded implementation. It is
+// kept as the default constructor for backward compatibility; callers that
+// already serialize access externally should use NewUnsafe instead to avoid
+// paying for the redundant locking.
+func New[T comparable]() Set[T] {
+	return NewSafe[T]()
+}
 
-// Set implements a set.
-type Set[T comparable] struct {
-	m  map[T]struct{}
-	mu sync.RWMutex
+// FromSlice returns a new Set containing the members of xs.
+func FromSlice[T comparable](xs []T) Set[T] {
+	s := New[T]()
+	s.PutAll(xs...)
+	return s
 }
 
-// New returns a new Set.
-func New[T comparable]() *Set[T] {
-	return &Set[T]{m: make(map[T]struct{})}
+// newResult returns a new, empty Set to accumulate the result of a
+// multi-operand operation into: the lock-free unsafeSet when every operand
+// is itself an unsafeSet, so the operation can skip locking entirely, or
+// the mutex-guarded safeSet otherwise.
+func newResult[T comparable](sets []Set[T]) Set[T] {
+	for _, s := range sets {
+		if _, ok := s.(*unsafeSet[T]); !ok {
+			return NewSafe[T]()
+		}
+	}
+	if len(sets) == 0 {
+		return NewSafe[T]()
+	}
+	return NewUnsafe[T]()
 }
 
 // Intersection returns the intersection of sets.
-func Intersection[T comparable](sets ...*Set[T]) *Set[T] {
-	i := New[T]()
+func Intersection[T comparable](sets ...Set[T]) Set[T] {
+	i := newResult(sets)
 	if len(sets) == 0 {
 		return i
 	}
 	smallest := sets[0]
-
 	for _, s := range sets {
-		s.mu.RLock()
-		defer s.mu.RUnlock()
-
-		if len(s.m) < len(smallest.m) {
+		if s.Len() < smallest.Len() {
 			smallest = s
 		}
 	}
 
-next:
-	for e := range smallest.m {
+	smallest.Range(func(e T) bool {
 		for _, s := range sets {
-			if _, ok := s.m[e]; !ok {
-				continue next
+			if !s.Has(e) {
+				return true
 			}
 		}
-		i.m[e] = struct{}{}
-	}
+		i.Put(e)
+		return true
+	})
 	return i
 }
 
 // Union returns the union of sets.
-func Union[T comparable](sets ...*Set[T]) *Set[T] {
-	u := New[T]()
+func Union[T comparable](sets ...Set[T]) Set[T] {
+	u := newResult(sets)
 	for _, s := range sets {
 		s.Range(func(e T) bool {
-			u.m[e] = struct{}{}
+			u.Put(e)
 			return true
 		})
 	}
 	return u
 }
-
-// Put puts e into s.
-func (s *Set[T]) Put(e T) {
-	s.mu.Lock()
-	s.m[e] = struct{}{}
-	s.mu.Unlock()
-}
-
-// Delete deletes e from s.
-func (s *Set[T]) Delete(e T) {
-	s.mu.Lock()
-	delete(s.m, e)
-	s.mu.Unlock()
-}
-
-// Has returns true if s has e.
-func (s *Set[T]) Has(e T) bool {
-	s.mu.RLock()
-	_, ok := s.m[e]
-	s.mu.RUnlock()
-	return ok
-}
-
-// Len returns the number of members of s.
-func (s *Set[T]) Len() int {
-	s.mu.RLock()
-	l := len(s.m)
-	s.mu.RUnlock()
-	return l
-}
-
-// Range calls f for each member of s, so long as it returns true.
-func (s *Set[T]) Range(f func(T) bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	for e := range s.m {
-		if !f(e) {
-			break
-		}
-	}
-}
-
-// Copy returns a copy of s.
-func (s *Set[T]) Copy() *Set[T] {
-	c := New[T]()
-	s.Range(func(e T) bool {
-		c.m[e] = struct{}{}
-		return true
-	})
-	return c
-}
-
-// Diff returns the result of s-t.
-func (s *Set[T]) Diff(t *Set[T]) *Set[T] {
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-
-	d := New[T]()
-	s.Range(func(e T) bool {
-		if _, ok := t.m[e]; !ok {
-			d.m[e] = struct{}{}
-		}
-		return true
-	})
-	return d
-}
-
-// Subset returns true if s is a subset of t.
-func (s *Set[T]) Subset(t *Set[T]) bool {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-
-	for e := range s.m {
-		if _, ok := t.m[e]; !ok {
-			return false
-		}
-	}
-	return true
-}
-
-// Equal returns true if s and t have the same members.
-func (s *Set[T]) Equal(t *Set[T]) bool {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-	t.mu.RLock()
-	defer t.mu.RUnlock()
-
-	if len(s.m) != len(t.m) {
-		return false
-	}
-	for e := range s.m {
-		if _, ok := t.m[e]; !ok {
-			return false
-		}
-	}
-	return true
-}
-
-// String returns the string representation of s.
-func (s *Set[T]) String() string {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	var sb strings.Builder
-	sb.WriteRune('{')
-
-	var i int
-	for e := range s.m {
-		i++
-		fmt.Fprint(&sb, e)
-		if i < len(s.m) {
-			sb.WriteString(", ")
-		}
-	}
-	sb.WriteRune('}')
-	return sb.String()
-}
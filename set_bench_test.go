@@ -0,0 +1,45 @@
+package set
+
+import "testing"
+
+func BenchmarkSafePut(b *testing.B) {
+	s := NewSafe[int]()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Put(i)
+	}
+}
+
+func BenchmarkUnsafePut(b *testing.B) {
+	s := NewUnsafe[int]()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Put(i)
+	}
+}
+
+func BenchmarkSafeUnion(b *testing.B) {
+	s := NewSafe[int]()
+	t := NewSafe[int]()
+	for i := 0; i < 1000; i++ {
+		s.Put(i)
+		t.Put(i + 500)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Union(s, t)
+	}
+}
+
+func BenchmarkUnsafeUnion(b *testing.B) {
+	s := NewUnsafe[int]()
+	t := NewUnsafe[int]()
+	for i := 0; i < 1000; i++ {
+		s.Put(i)
+		t.Put(i + 500)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Union(s, t)
+	}
+}
@@ -0,0 +1,208 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unsafeSet is a Set implementation with no internal locking, for callers
+// that already serialize access to it externally (e.g. behind a
+// higher-level lock) and want to avoid paying for a redundant
+// sync.RWMutex.
+type unsafeSet[T comparable] struct {
+	m map[T]struct{}
+}
+
+// NewUnsafe returns a new Set with no internal locking. Its methods must
+// not be called concurrently from multiple goroutines without external
+// synchronization.
+func NewUnsafe[T comparable]() Set[T] {
+	return &unsafeSet[T]{m: make(map[T]struct{})}
+}
+
+// Put puts e into s.
+func (s *unsafeSet[T]) Put(e T) {
+	s.m[e] = struct{}{}
+}
+
+// Delete deletes e from s.
+func (s *unsafeSet[T]) Delete(e T) {
+	delete(s.m, e)
+}
+
+// Remove is an alias for Delete.
+func (s *unsafeSet[T]) Remove(e T) {
+	s.Delete(e)
+}
+
+// PutAll puts each of xs into s.
+func (s *unsafeSet[T]) PutAll(xs ...T) {
+	for _, e := range xs {
+		s.m[e] = struct{}{}
+	}
+}
+
+// DeleteAll deletes each of xs from s.
+func (s *unsafeSet[T]) DeleteAll(xs ...T) {
+	for _, e := range xs {
+		delete(s.m, e)
+	}
+}
+
+// Clear removes all members from s.
+func (s *unsafeSet[T]) Clear() {
+	s.m = make(map[T]struct{})
+}
+
+// Has returns true if s has e.
+func (s *unsafeSet[T]) Has(e T) bool {
+	_, ok := s.m[e]
+	return ok
+}
+
+// Len returns the number of members of s.
+func (s *unsafeSet[T]) Len() int {
+	return len(s.m)
+}
+
+// Range calls f for each member of s, so long as it returns true.
+func (s *unsafeSet[T]) Range(f func(T) bool) {
+	for e := range s.m {
+		if !f(e) {
+			break
+		}
+	}
+}
+
+// Copy returns a copy of s.
+func (s *unsafeSet[T]) Copy() Set[T] {
+	c := NewUnsafe[T]()
+	s.Range(func(e T) bool {
+		c.Put(e)
+		return true
+	})
+	return c
+}
+
+// ToSlice returns the members of s as a slice, in no particular order.
+func (s *unsafeSet[T]) ToSlice() []T {
+	xs := make([]T, 0, len(s.m))
+	for e := range s.m {
+		xs = append(xs, e)
+	}
+	return xs
+}
+
+// Difference returns the result of s-t.
+func (s *unsafeSet[T]) Difference(t Set[T]) Set[T] {
+	d := NewUnsafe[T]()
+	s.Range(func(e T) bool {
+		if !t.Has(e) {
+			d.Put(e)
+		}
+		return true
+	})
+	return d
+}
+
+// Diff is a deprecated alias for Difference.
+//
+// Deprecated: use Difference instead.
+func (s *unsafeSet[T]) Diff(t Set[T]) Set[T] {
+	return s.Difference(t)
+}
+
+// SymmetricDifference returns the members in exactly one of s and t.
+func (s *unsafeSet[T]) SymmetricDifference(t Set[T]) Set[T] {
+	return Union[T](s.Difference(t), t.Difference(s))
+}
+
+// Subset returns true if s is a subset of t.
+func (s *unsafeSet[T]) Subset(t Set[T]) bool {
+	for e := range s.m {
+		if !t.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// ProperSubset returns true if s is a subset of t and the two are not equal.
+func (s *unsafeSet[T]) ProperSubset(t Set[T]) bool {
+	return s.Subset(t) && !s.Equal(t)
+}
+
+// ProperSuperset returns true if s is a superset of t and the two are not
+// equal.
+func (s *unsafeSet[T]) ProperSuperset(t Set[T]) bool {
+	return t.Subset(s) && !s.Equal(t)
+}
+
+// Equal returns true if s and t have the same members.
+func (s *unsafeSet[T]) Equal(t Set[T]) bool {
+	if len(s.m) != t.Len() {
+		return false
+	}
+	for e := range s.m {
+		if !t.Has(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the string representation of s.
+func (s *unsafeSet[T]) String() string {
+	var sb strings.Builder
+	sb.WriteRune('{')
+
+	var i int
+	for e := range s.m {
+		i++
+		fmt.Fprint(&sb, e)
+		if i < len(s.m) {
+			sb.WriteString(", ")
+		}
+	}
+	sb.WriteRune('}')
+	return sb.String()
+}
+
+// Iterator returns a pull-based iterator over s's members.
+func (s *unsafeSet[T]) Iterator() *Iterator[T] {
+	return newIterator[T](func(yield func(T) bool) {
+		for e := range s.m {
+			if !yield(e) {
+				return
+			}
+		}
+	}, nil)
+}
+
+// MarshalJSON returns the JSON encoding of s, as a flat array of members.
+func (s *unsafeSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalJSON[T](s)
+}
+
+// EncodeJSON returns the JSON encoding of s, as a flat array of members.
+func (s *unsafeSet[T]) EncodeJSON() ([]byte, error) {
+	return s.MarshalJSON()
+}
+
+// UnmarshalJSON decodes a flat JSON array of members into s, collapsing any
+// duplicates silently. Use the package-level DecodeJSON with
+// WithStrictDecode for strict duplicate checking.
+func (s *unsafeSet[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON[T](s, data)
+}
+
+// GobEncode implements gob.GobEncoder.
+func (s *unsafeSet[T]) GobEncode() ([]byte, error) {
+	return gobEncode[T](s)
+}
+
+// GobDecode implements gob.GobDecoder, collapsing any duplicate members
+// silently.
+func (s *unsafeSet[T]) GobDecode(data []byte) error {
+	return gobDecode[T](s, data)
+}